@@ -0,0 +1,78 @@
+// Package apierr defines the numeric error codes shared by the webserver's
+// HTTP handlers and the bilingual (EN/ZH) messages that go with them, so the
+// frontend can branch on a stable code or show localized text instead of
+// parsing free-form strings out of http.Error.
+package apierr
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Code is a stable numeric identifier for an API error condition.
+type Code int
+
+const (
+	ErrParamInvalid        Code = 1001
+	ErrPrivKeyFormat       Code = 2001
+	ErrPrivKeyParse        Code = 2002
+	ErrMnemonicInvalid     Code = 2003
+	ErrMnemonicGenFailed   Code = 2004
+	ErrTxBuildFailed       Code = 3001
+	ErrTxSubmitFailed      Code = 3002
+	ErrTxNotFound          Code = 3003
+	ErrGuarGroupJoinFailed Code = 4001
+)
+
+// entry pairs a code with its HTTP status and bilingual message.
+type entry struct {
+	Status int
+	MsgEN  string
+	MsgZH  string
+}
+
+var registry = map[Code]entry{
+	ErrParamInvalid:        {http.StatusBadRequest, "invalid request parameters", "请求参数不合法"},
+	ErrPrivKeyFormat:       {http.StatusBadRequest, "private key must be 64 hex characters", "私钥格式错误，需为64位十六进制字符串"},
+	ErrPrivKeyParse:        {http.StatusBadRequest, "failed to parse private key", "私钥解析失败"},
+	ErrMnemonicInvalid:     {http.StatusBadRequest, "invalid mnemonic or derivation failed", "助记词无效或派生失败"},
+	ErrMnemonicGenFailed:   {http.StatusInternalServerError, "failed to generate mnemonic", "生成助记词失败"},
+	ErrTxBuildFailed:       {http.StatusBadRequest, "failed to build transaction", "构造交易失败"},
+	ErrTxSubmitFailed:      {http.StatusBadRequest, "failed to submit transaction", "提交交易失败"},
+	ErrTxNotFound:          {http.StatusNotFound, "transaction not found", "交易不存在"},
+	ErrGuarGroupJoinFailed: {http.StatusBadRequest, "failed to join guarantor group", "加入担保组织失败"},
+}
+
+// Body is the JSON shape written by WriteError.
+type Body struct {
+	Code   Code   `json:"code"`
+	MsgEN  string `json:"msgEN"`
+	MsgZH  string `json:"msgZH"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// WriteError writes the JSON error envelope for code, using cause (if any)
+// as the detail field, and sets the HTTP status registered for that code.
+func WriteError(w http.ResponseWriter, code Code, cause error) {
+	e, ok := registry[code]
+	if !ok {
+		e = entry{Status: http.StatusInternalServerError, MsgEN: "unknown error", MsgZH: "未知错误"}
+	}
+	detail := ""
+	if cause != nil {
+		detail = cause.Error()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.Status)
+	_ = json.NewEncoder(w).Encode(Body{Code: code, MsgEN: e.MsgEN, MsgZH: e.MsgZH, Detail: detail})
+}
+
+// Dictionary returns the full code -> message mapping for /api/errors, so
+// the frontend can render localized text without hard-coding strings.
+func Dictionary() map[Code]Body {
+	out := make(map[Code]Body, len(registry))
+	for code, e := range registry {
+		out[code] = Body{Code: code, MsgEN: e.MsgEN, MsgZH: e.MsgZH}
+	}
+	return out
+}
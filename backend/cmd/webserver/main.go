@@ -3,15 +3,21 @@ package main
 import (
     "crypto/sha256"
     "encoding/json"
+    "errors"
     "fmt"
     "hash/crc32"
     "log"
+    "math/big"
     "net/http"
     "os"
     "path/filepath"
+    "TransferAreaInterface/backend/apierr"
     corepkg "TransferAreaInterface/backend/core"
+    "TransferAreaInterface/backend/core/hdwallet"
     "regexp"
     "strings"
+
+    "golang.org/x/crypto/sha3"
 )
 
 // resolveStaticRoot attempts to locate the project root that contains index.html
@@ -51,20 +57,24 @@ func main() {
         }
         type req struct{ PrivHex string `json:"privHex"` }
         type resp struct {
-            AccountID string `json:"accountId"`
-            Address   string `json:"address"`
-            PrivHex   string `json:"privHex"`
-            PubXHex   string `json:"pubXHex"`
-            PubYHex   string `json:"pubYHex"`
+            AccountID        string `json:"accountId"`
+            Curve            string `json:"curve"`
+            Address          string `json:"address"`
+            AddressB58       string `json:"addressB58"`
+            AddressSecp256k1 string               `json:"addressSecp256k1,omitempty"`
+            PrivHex          string               `json:"privHex"`
+            PubXHex          string               `json:"pubXHex"`
+            PubYHex          string               `json:"pubYHex"`
+            PublicKey        corepkg.PublicKeyNew `json:"publicKey"`
         }
         var q req
         if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
-            http.Error(w, "bad request", http.StatusBadRequest)
+            apierr.WriteError(w, apierr.ErrParamInvalid, err)
             return
         }
         priv := strings.TrimSpace(q.PrivHex)
         if len(priv) == 0 {
-            http.Error(w, "empty privHex", http.StatusBadRequest)
+            apierr.WriteError(w, apierr.ErrParamInvalid, fmt.Errorf("empty privHex"))
             return
         }
         // 规范化：移除 0x 前缀、大小写忽略，要求恰好 64 位十六进制
@@ -72,46 +82,247 @@ func main() {
             priv = priv[2:]
         }
         if !regexp.MustCompile(`^(?i)[0-9a-f]{64}$`).MatchString(priv) {
-            http.Error(w, "invalid privHex format: require 64 hex characters", http.StatusBadRequest)
+            apierr.WriteError(w, apierr.ErrPrivKeyFormat, fmt.Errorf("require 64 hex characters"))
+            return
+        }
+        // curve 参数决定主账户所绑定的曲线，默认沿用原有的 P-256
+        curveParam := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("curve")))
+        if curveParam == "" {
+            curveParam = string(corepkg.CurveP256)
+        }
+        curve := corepkg.CurveID(curveParam)
+        if curve != corepkg.CurveP256 && curve != corepkg.CurveSecp256k1 {
+            apierr.WriteError(w, apierr.ErrParamInvalid, fmt.Errorf("unsupported curve %q", curveParam))
             return
         }
         // 使用 core 包解析私钥并获取公钥
-        pk, err := corepkg.ParsePrivateKey(priv)
+        pk, err := corepkg.ParsePrivateKey(priv, curve)
         if err != nil {
-            http.Error(w, "invalid private key: "+err.Error(), http.StatusBadRequest)
-            return
-        }
-        x := pk.PublicKey.X
-        y := pk.PublicKey.Y
-        pubXHex := fmt.Sprintf("%064x", x)
-        pubYHex := fmt.Sprintf("%064x", y)
-        // 未压缩公钥: 0x04 || X || Y（每个坐标填充为32字节）
-        xb := x.Bytes()
-        yb := y.Bytes()
-        // 确保坐标长度为 32 字节（P-256），进行前导零填充
-        pad := func(b []byte) []byte {
-            if len(b) >= 32 { return b }
-            p := make([]byte, 32)
-            copy(p[32-len(b):], b)
-            return p
-        }
-        xb = pad(xb)
-        yb = pad(yb)
-        uncompressed := make([]byte, 1+32+32)
-        uncompressed[0] = 0x04
-        copy(uncompressed[1:33], xb)
-        copy(uncompressed[33:], yb)
-        // 地址 = SHA-256(uncompressed)[0..20]
-        sha := sha256.Sum256(uncompressed)
-        address := hexLower(sha[:20])
+            apierr.WriteError(w, apierr.ErrPrivKeyParse, err)
+            return
+        }
+        // legacy 地址方案（SHA-256 哈希 + Base58Check）仅对 P-256 账户有定义；
+        // 跨链互通：同一私钥若在 secp256k1 上也合法，一并派生以太坊风格地址，
+        // 使同一账户可以持有跨链 UTXO
+        var fields keyFields
+        addrSecp256k1 := ""
+        switch curve {
+        case corepkg.CurveP256:
+            fields = deriveKeyFields(pk.PublicKey.X, pk.PublicKey.Y)
+            if altPK, err := corepkg.ParsePrivateKey(priv, corepkg.CurveSecp256k1); err == nil {
+                addrSecp256k1 = deriveSecp256k1Address(altPK.PublicKey.X, altPK.PublicKey.Y)
+            }
+        case corepkg.CurveSecp256k1:
+            fields.PubXHex = fmt.Sprintf("%064x", pk.PublicKey.X)
+            fields.PubYHex = fmt.Sprintf("%064x", pk.PublicKey.Y)
+            addrSecp256k1 = deriveSecp256k1Address(pk.PublicKey.X, pk.PublicKey.Y)
+            if altPK, err := corepkg.ParsePrivateKey(priv, corepkg.CurveP256); err == nil {
+                altFields := deriveKeyFields(altPK.PublicKey.X, altPK.PublicKey.Y)
+                fields.Address, fields.AddressB58 = altFields.Address, altFields.AddressB58
+            }
+        }
 
         // 账户ID：与后端 Generate8DigitNumberBasedOnInput 保持一致（基于规范化后的私钥）
-        hash := crc32.ChecksumIEEE([]byte(priv))
-        num := int(hash%90000000) + 10000000
-        accountID := fmt.Sprintf("%08d", num)
+        accountID := accountIDFromSeed(priv)
+
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(resp{
+            AccountID:        accountID,
+            Curve:            string(curve),
+            Address:          fields.Address,
+            AddressB58:       fields.AddressB58,
+            AddressSecp256k1: addrSecp256k1,
+            PrivHex:          priv,
+            PubXHex:          fields.PubXHex,
+            PubYHex:          fields.PubYHex,
+            PublicKey:        corepkg.ConvertToPublicKeyNew(pk.PublicKey, curve),
+        })
+    })
+    // API: 从助记词恢复账户（可选带 derivation path 与密语）
+    http.HandleFunc("/api/keys/from-mnemonic", func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodPost {
+            http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+            return
+        }
+        type req struct {
+            Mnemonic   string `json:"mnemonic"`
+            Passphrase string `json:"passphrase"`
+            Path       string `json:"path"`
+        }
+        type resp struct {
+            AccountID      string `json:"accountId"`
+            Address        string `json:"address"`
+            AddressB58     string `json:"addressB58"`
+            PubXHex        string `json:"pubXHex"`
+            PubYHex        string `json:"pubYHex"`
+            DerivationPath string `json:"derivationPath"`
+            SeedFingerprint string `json:"seedFingerprint"`
+            Xpub           string `json:"xpub"`
+        }
+        var q req
+        if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+            apierr.WriteError(w, apierr.ErrParamInvalid, err)
+            return
+        }
+        path := strings.TrimSpace(q.Path)
+        if path == "" {
+            path = "m/44'/60'/0'/0/0"
+        }
+        seed, err := hdwallet.SeedFromMnemonic(strings.TrimSpace(q.Mnemonic), q.Passphrase)
+        if err != nil {
+            apierr.WriteError(w, apierr.ErrMnemonicInvalid, err)
+            return
+        }
+        master, err := hdwallet.NewMasterKey(seed)
+        if err != nil {
+            apierr.WriteError(w, apierr.ErrMnemonicInvalid, err)
+            return
+        }
+        leaf, err := hdwallet.DerivePath(master, path)
+        if err != nil {
+            apierr.WriteError(w, apierr.ErrParamInvalid, err)
+            return
+        }
+        fields := deriveKeyFields(leaf.PubX, leaf.PubY)
+        accountID := accountIDFromSeed(fmt.Sprintf("%064x", leaf.D))
 
         w.Header().Set("Content-Type", "application/json")
-        _ = json.NewEncoder(w).Encode(resp{AccountID: accountID, Address: address, PrivHex: priv, PubXHex: pubXHex, PubYHex: pubYHex})
+        _ = json.NewEncoder(w).Encode(resp{
+            AccountID:       accountID,
+            Address:         fields.Address,
+            AddressB58:      fields.AddressB58,
+            PubXHex:         fields.PubXHex,
+            PubYHex:         fields.PubYHex,
+            DerivationPath:  path,
+            SeedFingerprint: hdwallet.Fingerprint(seed),
+            Xpub:            leaf.Xpub(),
+        })
+    })
+    // API: 生成一份新的 BIP-39 助记词，供用户备份账户
+    http.HandleFunc("/api/keys/generate-mnemonic", func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodPost {
+            http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+            return
+        }
+        type resp struct {
+            Mnemonic string `json:"mnemonic"`
+        }
+        mnemonic, err := hdwallet.GenerateMnemonic(128)
+        if err != nil {
+            apierr.WriteError(w, apierr.ErrMnemonicGenFailed, err)
+            return
+        }
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(resp{Mnemonic: mnemonic})
+    })
+    // API: 根据前端参数构造一笔未签名交易
+    http.HandleFunc("/api/tx/build", func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodPost {
+            http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+            return
+        }
+        var info corepkg.BuildTXInfo
+        if err := json.NewDecoder(r.Body).Decode(&info); err != nil {
+            apierr.WriteError(w, apierr.ErrParamInvalid, err)
+            return
+        }
+        tx, err := corepkg.BuildNewTX(info)
+        if err != nil {
+            if errors.Is(err, corepkg.ErrInvalidAddress) {
+                apierr.WriteError(w, apierr.ErrParamInvalid, err)
+                return
+            }
+            apierr.WriteError(w, apierr.ErrTxBuildFailed, err)
+            return
+        }
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(tx)
+    })
+    // API: 使用私钥对已构造的交易进行签名
+    http.HandleFunc("/api/tx/sign", func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodPost {
+            http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+            return
+        }
+        type req struct {
+            Tx      corepkg.SubATX `json:"tx"`
+            PrivHex string         `json:"privHex"`
+        }
+        var q req
+        if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+            apierr.WriteError(w, apierr.ErrParamInvalid, err)
+            return
+        }
+        signed, err := corepkg.SignTX(&q.Tx, strings.TrimSpace(q.PrivHex))
+        if err != nil {
+            if errors.Is(err, corepkg.ErrInvalidAddress) {
+                apierr.WriteError(w, apierr.ErrParamInvalid, err)
+                return
+            }
+            apierr.WriteError(w, apierr.ErrTxBuildFailed, err)
+            return
+        }
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(signed)
+    })
+    // API: 将已签名交易提交到节点
+    http.HandleFunc("/api/tx/submit", func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodPost {
+            http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+            return
+        }
+        var tx corepkg.SubATX
+        if err := json.NewDecoder(r.Body).Decode(&tx); err != nil {
+            apierr.WriteError(w, apierr.ErrParamInvalid, err)
+            return
+        }
+        if err := corepkg.SendTX(&tx); err != nil {
+            if errors.Is(err, corepkg.ErrInvalidAddress) {
+                apierr.WriteError(w, apierr.ErrParamInvalid, err)
+                return
+            }
+            apierr.WriteError(w, apierr.ErrTxSubmitFailed, err)
+            return
+        }
+        type resp struct {
+            TXID   string          `json:"txId"`
+            Status corepkg.TxStatus `json:"status"`
+        }
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(resp{TXID: tx.TXID, Status: corepkg.TxStatusPending})
+    })
+    // API: 轮询节点，查询交易是否已上链及其区块位置
+    http.HandleFunc("/api/tx/status", func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodGet {
+            http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+            return
+        }
+        txid := strings.TrimSpace(r.URL.Query().Get("txid"))
+        if txid == "" {
+            apierr.WriteError(w, apierr.ErrParamInvalid, fmt.Errorf("missing txid"))
+            return
+        }
+        status, pos, err := corepkg.QueryTxStatus(txid)
+        if err != nil {
+            apierr.WriteError(w, apierr.ErrTxNotFound, err)
+            return
+        }
+        type resp struct {
+            TXID     string           `json:"txId"`
+            Status   corepkg.TxStatus `json:"status"`
+            Position corepkg.TxPosition `json:"position"`
+        }
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(resp{TXID: txid, Status: status, Position: pos})
+    })
+    // API: 返回错误码 -> 本地化消息的完整字典，供前端渲染本地化文本
+    http.HandleFunc("/api/errors", func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodGet {
+            http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+            return
+        }
+        w.Header().Set("Content-Type", "application/json")
+        _ = json.NewEncoder(w).Encode(apierr.Dictionary())
     })
     port := os.Getenv("PORT")
     if port == "" {
@@ -121,6 +332,65 @@ func main() {
     log.Fatal(http.ListenAndServe(":"+port, nil))
 }
 
+// keyFields 是由公钥坐标推导出的、可直接写入响应体的账户展示字段
+type keyFields struct {
+    Address    string
+    AddressB58 string
+    PubXHex    string
+    PubYHex    string
+}
+
+// deriveKeyFields 由 P-256 公钥坐标计算十六进制坐标、legacy 地址与 Base58Check 地址
+func deriveKeyFields(x, y *big.Int) keyFields {
+    pubXHex := fmt.Sprintf("%064x", x)
+    pubYHex := fmt.Sprintf("%064x", y)
+    // 未压缩公钥: 0x04 || X || Y（每个坐标填充为32字节）
+    pad := func(b []byte) []byte {
+        if len(b) >= 32 { return b }
+        p := make([]byte, 32)
+        copy(p[32-len(b):], b)
+        return p
+    }
+    uncompressed := make([]byte, 1+32+32)
+    uncompressed[0] = 0x04
+    copy(uncompressed[1:33], pad(x.Bytes()))
+    copy(uncompressed[33:], pad(y.Bytes()))
+    // 地址 = SHA-256(uncompressed)[0..20]
+    sha := sha256.Sum256(uncompressed)
+    address := hexLower(sha[:20])
+    // 新版 Base58Check 地址，附带版本字节与校验和，便于用户发现抄写错误
+    addrB58 := ""
+    if a, err := corepkg.NewAddress(sha[:20]); err == nil {
+        addrB58 = a.Encode()
+    }
+    return keyFields{Address: address, AddressB58: addrB58, PubXHex: pubXHex, PubYHex: pubYHex}
+}
+
+// deriveSecp256k1Address 按以太坊风格计算地址：Keccak256(未压缩公钥去掉0x04前缀)[12:]，
+// 使 secp256k1 账户可以直接对接 Bitcoin/Ethereum 风格的跨链 UTXO
+func deriveSecp256k1Address(x, y *big.Int) string {
+    pad := func(b []byte) []byte {
+        if len(b) >= 32 { return b }
+        p := make([]byte, 32)
+        copy(p[32-len(b):], b)
+        return p
+    }
+    uncompressed := make([]byte, 64)
+    copy(uncompressed[0:32], pad(x.Bytes()))
+    copy(uncompressed[32:64], pad(y.Bytes()))
+    hash := sha3.NewLegacyKeccak256()
+    hash.Write(uncompressed)
+    sum := hash.Sum(nil)
+    return "0x" + hexLower(sum[12:])
+}
+
+// accountIDFromSeed 与后端 Generate8DigitNumberBasedOnInput 保持一致，基于输入字符串生成8位账户ID
+func accountIDFromSeed(seed string) string {
+    hash := crc32.ChecksumIEEE([]byte(seed))
+    num := int(hash%90000000) + 10000000
+    return fmt.Sprintf("%08d", num)
+}
+
 // hexLower 将字节切片转为小写十六进制字符串
 func hexLower(b []byte) string {
     const hexdigits = "0123456789abcdef"
@@ -0,0 +1,184 @@
+package core
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+// addressVersion 是 Base58Check 地址的版本前缀字节，
+// 预留单字节以便未来区分主网/测试网或不同地址类型。
+const addressVersion byte = 0x00
+
+// base58Alphabet 与比特币风格钱包保持一致，剔除了易混淆字符（0, O, I, l）
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var bigRadix = big.NewInt(58)
+
+// Address 封装 20 字节的账户哈希，并提供 Base58Check 编解码，
+// 使用户可以在粘贴/抄写地址时通过校验和发现输入错误。
+type Address struct {
+	hash [20]byte
+}
+
+// NewAddress 由 20 字节哈希构造一个 Address
+func NewAddress(hash []byte) (Address, error) {
+	if len(hash) != 20 {
+		return Address{}, errors.New("address hash must be 20 bytes")
+	}
+	var a Address
+	copy(a.hash[:], hash)
+	return a, nil
+}
+
+// Hash 返回地址底层的 20 字节哈希
+func (a Address) Hash() []byte {
+	out := make([]byte, 20)
+	copy(out, a.hash[:])
+	return out
+}
+
+// Encode 将地址编码为 Base58Check 字符串：
+// version(1B) || hash(20B) || checksum(4B，双 SHA-256 前四字节)
+func (a Address) Encode() string {
+	payload := make([]byte, 0, 1+20)
+	payload = append(payload, addressVersion)
+	payload = append(payload, a.hash[:]...)
+	checksum := doubleSha256(payload)[:4]
+	full := append(payload, checksum...)
+	return base58Encode(full)
+}
+
+// DecodeAddress 解析一个 Base58Check 地址字符串，校验版本与校验和
+func DecodeAddress(s string) (Address, error) {
+	full, err := base58Decode(s)
+	if err != nil {
+		return Address{}, err
+	}
+	if len(full) != 1+20+4 {
+		return Address{}, errors.New("invalid address length")
+	}
+	payload := full[:len(full)-4]
+	checksum := full[len(full)-4:]
+	want := doubleSha256(payload)[:4]
+	if !bytes.Equal(checksum, want) {
+		return Address{}, errors.New("address checksum mismatch")
+	}
+	if payload[0] != addressVersion {
+		return Address{}, errors.New("unsupported address version")
+	}
+	return NewAddress(payload[1:])
+}
+
+// ParseAddress 接受旧版原始 Hex 地址或新版 Base58Check 地址，
+// 使历史存量数据在迁移期间仍可被正确解析。
+func ParseAddress(s string) (Address, error) {
+	if a, err := DecodeAddress(s); err == nil {
+		return a, nil
+	}
+	if len(s) == 40 {
+		hash, err := hexDecode(s)
+		if err == nil && len(hash) == 20 {
+			return NewAddress(hash)
+		}
+	}
+	return Address{}, errors.New("address is neither valid base58check nor legacy hex")
+}
+
+func doubleSha256(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+func hexDecode(s string) ([]byte, error) {
+	if len(s)%2 != 0 {
+		return nil, errors.New("odd length hex string")
+	}
+	out := make([]byte, len(s)/2)
+	for i := 0; i < len(out); i++ {
+		hi, err := hexNibble(s[i*2])
+		if err != nil {
+			return nil, err
+		}
+		lo, err := hexNibble(s[i*2+1])
+		if err != nil {
+			return nil, err
+		}
+		out[i] = hi<<4 | lo
+	}
+	return out, nil
+}
+
+func hexNibble(c byte) (byte, error) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', nil
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, nil
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, nil
+	default:
+		return 0, errors.New("invalid hex character")
+	}
+}
+
+// base58Encode 将字节切片编码为 Base58 字符串，保留前导零字节为 '1'
+func base58Encode(b []byte) string {
+	x := new(big.Int).SetBytes(b)
+	mod := new(big.Int)
+	var out []byte
+	zero := big.NewInt(0)
+	for x.Cmp(zero) > 0 {
+		x.DivMod(x, bigRadix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	reverse(out)
+	return string(out)
+}
+
+// base58Decode 解码 Base58 字符串为原始字节，保留前导 '1' 为零字节
+func base58Decode(s string) ([]byte, error) {
+	x := big.NewInt(0)
+	for _, c := range []byte(s) {
+		idx := indexByte(base58Alphabet, c)
+		if idx < 0 {
+			return nil, errors.New("invalid base58 character")
+		}
+		x.Mul(x, bigRadix)
+		x.Add(x, big.NewInt(int64(idx)))
+	}
+	decoded := x.Bytes()
+	leadingZeros := 0
+	for _, c := range []byte(s) {
+		if c != base58Alphabet[0] {
+			break
+		}
+		leadingZeros++
+	}
+	out := make([]byte, leadingZeros+len(decoded))
+	copy(out[leadingZeros:], decoded)
+	return out, nil
+}
+
+func indexByte(s string, b byte) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == b {
+			return i
+		}
+	}
+	return -1
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
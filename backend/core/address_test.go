@@ -0,0 +1,78 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAddressEncodeDecodeRoundTrip(t *testing.T) {
+	cases := [][]byte{
+		bytes.Repeat([]byte{0x00}, 20),
+		bytes.Repeat([]byte{0xff}, 20),
+		{0x01, 0x09, 0x66, 0x77, 0x60, 0x06, 0x95, 0x3d, 0x55, 0x67, 0x43, 0x9e, 0x5e, 0x39, 0xf8, 0x6a, 0x0d, 0x27, 0x3b, 0xee},
+	}
+	for _, hash := range cases {
+		a, err := NewAddress(hash)
+		if err != nil {
+			t.Fatalf("NewAddress(%x): %v", hash, err)
+		}
+		encoded := a.Encode()
+		decoded, err := DecodeAddress(encoded)
+		if err != nil {
+			t.Fatalf("DecodeAddress(%q): %v", encoded, err)
+		}
+		if !bytes.Equal(decoded.Hash(), hash) {
+			t.Fatalf("round trip hash mismatch: got %x want %x", decoded.Hash(), hash)
+		}
+	}
+}
+
+func TestDecodeAddressRejectsBadChecksum(t *testing.T) {
+	a, err := NewAddress(bytes.Repeat([]byte{0xAB}, 20))
+	if err != nil {
+		t.Fatalf("NewAddress: %v", err)
+	}
+	encoded := []byte(a.Encode())
+	// flip the last character so the checksum no longer matches
+	if encoded[len(encoded)-1] == '1' {
+		encoded[len(encoded)-1] = '2'
+	} else {
+		encoded[len(encoded)-1] = '1'
+	}
+	if _, err := DecodeAddress(string(encoded)); err == nil {
+		t.Fatal("expected checksum mismatch error, got nil")
+	}
+}
+
+func TestDecodeAddressRejectsInvalidBase58(t *testing.T) {
+	if _, err := DecodeAddress("not-base58!"); err == nil {
+		t.Fatal("expected error decoding invalid base58 string, got nil")
+	}
+}
+
+func TestParseAddressAcceptsLegacyHex(t *testing.T) {
+	hash := bytes.Repeat([]byte{0x12}, 20)
+	legacy := ""
+	for _, b := range hash {
+		legacy += string("0123456789abcdef"[b>>4]) + string("0123456789abcdef"[b&0x0f])
+	}
+	a, err := ParseAddress(legacy)
+	if err != nil {
+		t.Fatalf("ParseAddress(%q): %v", legacy, err)
+	}
+	if !bytes.Equal(a.Hash(), hash) {
+		t.Fatalf("legacy hex hash mismatch: got %x want %x", a.Hash(), hash)
+	}
+}
+
+func TestBase58EncodeDecodePreservesLeadingZeros(t *testing.T) {
+	in := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	encoded := base58Encode(in)
+	decoded, err := base58Decode(encoded)
+	if err != nil {
+		t.Fatalf("base58Decode(%q): %v", encoded, err)
+	}
+	if !bytes.Equal(decoded, in) {
+		t.Fatalf("base58 round trip mismatch: got %x want %x", decoded, in)
+	}
+}
@@ -0,0 +1,141 @@
+package hdwallet
+
+import (
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// hardenedOffset is added to a path component's index to mark it hardened,
+// matching BIP-32's convention (index' == index + 2^31).
+const hardenedOffset = uint32(1) << 31
+
+// masterSeedKey is the HMAC key used to derive the master node, analogous to
+// "Bitcoin seed" in BIP-32 but scoped to the P-256 curve used by this wallet.
+const masterSeedKey = "Nist256p1 seed"
+
+// ExtendedKey is a single node in a BIP-32 style derivation tree over P-256.
+type ExtendedKey struct {
+	Curve      elliptic.Curve
+	D          *big.Int // private scalar, nil for a public-only node
+	PubX, PubY *big.Int
+	ChainCode  []byte
+	Depth      uint8
+	Index      uint32
+}
+
+// NewMasterKey derives the root ExtendedKey from a BIP-39 seed.
+func NewMasterKey(seed []byte) (*ExtendedKey, error) {
+	mac := hmac.New(sha512.New, []byte(masterSeedKey))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	il, ir := sum[:32], sum[32:]
+
+	curve := elliptic.P256()
+	d := new(big.Int).SetBytes(il)
+	if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, errors.New("invalid master key, derived scalar out of range")
+	}
+	x, y := curve.ScalarBaseMult(il)
+	return &ExtendedKey{Curve: curve, D: d, PubX: x, PubY: y, ChainCode: ir, Depth: 0, Index: 0}, nil
+}
+
+// Child derives the child key at the given index. Hardened derivation
+// (index >= 2^31, conventionally written as e.g. 44') requires the parent's
+// private key; non-hardened derivation only needs the parent's public key.
+func (k *ExtendedKey) Child(index uint32) (*ExtendedKey, error) {
+	hardened := index >= hardenedOffset
+
+	var data []byte
+	if hardened {
+		if k.D == nil {
+			return nil, errors.New("cannot derive hardened child from public-only key")
+		}
+		data = append([]byte{0x00}, leftPad32(k.D.Bytes())...)
+	} else {
+		data = append([]byte{0x02 | byte(k.PubY.Bit(0))}, leftPad32(k.PubX.Bytes())...)
+	}
+	var idxBuf [4]byte
+	binary.BigEndian.PutUint32(idxBuf[:], index)
+	data = append(data, idxBuf[:]...)
+
+	mac := hmac.New(sha512.New, k.ChainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	il, ir := sum[:32], sum[32:]
+
+	ilNum := new(big.Int).SetBytes(il)
+	n := k.Curve.Params().N
+	if ilNum.Cmp(n) >= 0 {
+		return nil, errors.New("invalid child, IL out of range")
+	}
+
+	child := &ExtendedKey{Curve: k.Curve, ChainCode: ir, Depth: k.Depth + 1, Index: index}
+	if k.D != nil {
+		d := new(big.Int).Add(ilNum, k.D)
+		d.Mod(d, n)
+		if d.Sign() == 0 {
+			return nil, errors.New("invalid child, resulting key is zero")
+		}
+		child.D = d
+		child.PubX, child.PubY = k.Curve.ScalarBaseMult(d.Bytes())
+	} else {
+		ilX, ilY := k.Curve.ScalarBaseMult(il)
+		child.PubX, child.PubY = k.Curve.Add(ilX, ilY, k.PubX, k.PubY)
+	}
+	return child, nil
+}
+
+// DerivePath walks a BIP-32 path such as "m/44'/60'/0'/0/0" from the given
+// master key, returning the resulting leaf key.
+func DerivePath(master *ExtendedKey, path string) (*ExtendedKey, error) {
+	segments := strings.Split(path, "/")
+	if len(segments) == 0 || segments[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path %q: must start with \"m\"", path)
+	}
+	key := master
+	for _, seg := range segments[1:] {
+		if seg == "" {
+			continue
+		}
+		hardened := strings.HasSuffix(seg, "'")
+		numStr := strings.TrimSuffix(seg, "'")
+		n, err := strconv.ParseUint(numStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path segment %q: %w", seg, err)
+		}
+		index := uint32(n)
+		if hardened {
+			index += hardenedOffset
+		}
+		key, err = key.Child(index)
+		if err != nil {
+			return nil, fmt.Errorf("derive %q: %w", seg, err)
+		}
+	}
+	return key, nil
+}
+
+// Xpub returns a compact hex encoding of the extended public key
+// (compressed point || chain code), suitable for sharing a watch-only
+// account without exposing the private scalar.
+func (k *ExtendedKey) Xpub() string {
+	prefix := byte(0x02 | byte(k.PubY.Bit(0)))
+	compressed := append([]byte{prefix}, leftPad32(k.PubX.Bytes())...)
+	return hexEncode(compressed) + hexEncode(k.ChainCode)
+}
+
+func leftPad32(b []byte) []byte {
+	if len(b) >= 32 {
+		return b
+	}
+	p := make([]byte, 32)
+	copy(p[32-len(b):], b)
+	return p
+}
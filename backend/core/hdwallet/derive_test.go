@@ -0,0 +1,103 @@
+package hdwallet
+
+import (
+	"bytes"
+	"testing"
+)
+
+func testSeed(t *testing.T) []byte {
+	t.Helper()
+	const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	seed, err := SeedFromMnemonic(mnemonic, "")
+	if err != nil {
+		t.Fatalf("SeedFromMnemonic: %v", err)
+	}
+	return seed
+}
+
+func TestNewMasterKeyIsDeterministic(t *testing.T) {
+	seed := testSeed(t)
+	a, err := NewMasterKey(seed)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	b, err := NewMasterKey(seed)
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	if a.D.Cmp(b.D) != 0 || a.PubX.Cmp(b.PubX) != 0 || a.PubY.Cmp(b.PubY) != 0 {
+		t.Fatal("NewMasterKey is not deterministic for the same seed")
+	}
+	if !bytes.Equal(a.ChainCode, b.ChainCode) {
+		t.Fatal("chain code is not deterministic for the same seed")
+	}
+}
+
+func TestChildPublicKeyMatchesPrivateDerivation(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t))
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	child, err := master.Child(0)
+	if err != nil {
+		t.Fatalf("Child(0): %v", err)
+	}
+	publicOnly := &ExtendedKey{Curve: master.Curve, PubX: master.PubX, PubY: master.PubY, ChainCode: master.ChainCode}
+	pubChild, err := publicOnly.Child(0)
+	if err != nil {
+		t.Fatalf("public-only Child(0): %v", err)
+	}
+	// The public key implied by the derived private scalar must match the
+	// public key the curve addition produced.
+	wantX, wantY := child.Curve.ScalarBaseMult(child.D.Bytes())
+	if wantX.Cmp(pubChild.PubX) != 0 || wantY.Cmp(pubChild.PubY) != 0 {
+		t.Fatal("child public key does not match scalar base multiplication of child private key")
+	}
+}
+
+func TestHardenedChildRequiresPrivateKey(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t))
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	child, err := master.Child(hardenedOffset)
+	if err != nil {
+		t.Fatalf("Child(hardened): %v", err)
+	}
+	publicOnly := &ExtendedKey{Curve: child.Curve, PubX: master.PubX, PubY: master.PubY, ChainCode: master.ChainCode}
+	if _, err := publicOnly.Child(hardenedOffset); err == nil {
+		t.Fatal("expected error deriving a hardened child from a public-only key, got nil")
+	}
+}
+
+func TestDerivePathIsDeterministic(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t))
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	const path = "m/44'/60'/0'/0/0"
+	a, err := DerivePath(master, path)
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	b, err := DerivePath(master, path)
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	if a.D.Cmp(b.D) != 0 || a.Xpub() != b.Xpub() {
+		t.Fatal("DerivePath is not deterministic for the same path")
+	}
+}
+
+func TestDerivePathRejectsMalformedPath(t *testing.T) {
+	master, err := NewMasterKey(testSeed(t))
+	if err != nil {
+		t.Fatalf("NewMasterKey: %v", err)
+	}
+	if _, err := DerivePath(master, "44'/0'/0'"); err == nil {
+		t.Fatal("expected error for path missing leading \"m\", got nil")
+	}
+	if _, err := DerivePath(master, "m/not-a-number"); err == nil {
+		t.Fatal("expected error for non-numeric path segment, got nil")
+	}
+}
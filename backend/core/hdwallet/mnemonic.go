@@ -0,0 +1,182 @@
+// Package hdwallet implements BIP-39 mnemonic generation/validation and
+// BIP-32 style hierarchical deterministic key derivation over the P-256
+// curve, so accounts can be backed up and restored from a human-readable
+// phrase instead of a raw 64-hex private key.
+package hdwallet
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	pbkdf2Iterations = 2048
+	seedKeyLen       = 64
+)
+
+var wordIndex = buildWordIndex()
+
+func buildWordIndex() map[string]int {
+	m := make(map[string]int, len(English))
+	for i, w := range English {
+		m[w] = i
+	}
+	return m
+}
+
+// entropyBitsForWords returns the entropy size in bits for a given mnemonic
+// word count, per BIP-39 (ENT = 32*n/3 for n in {12,15,18,21,24}).
+func entropyBitsForWords(words int) (int, bool) {
+	switch words {
+	case 12:
+		return 128, true
+	case 15:
+		return 160, true
+	case 18:
+		return 192, true
+	case 21:
+		return 224, true
+	case 24:
+		return 256, true
+	default:
+		return 0, false
+	}
+}
+
+// GenerateMnemonic creates a new BIP-39 English mnemonic with the requested
+// entropy strength in bits (128, 160, 192, 224 or 256).
+func GenerateMnemonic(entropyBits int) (string, error) {
+	if entropyBits%32 != 0 || entropyBits < 128 || entropyBits > 256 {
+		return "", errors.New("entropy bits must be one of 128, 160, 192, 224, 256")
+	}
+	entropy := make([]byte, entropyBits/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", err
+	}
+	return entropyToMnemonic(entropy)
+}
+
+// entropyToMnemonic appends a checksum (first ENT/32 bits of SHA-256(entropy))
+// to the entropy and maps the resulting bitstream onto 11-bit word indices.
+func entropyToMnemonic(entropy []byte) (string, error) {
+	entBits := len(entropy) * 8
+	checksumBits := entBits / 32
+	hash := sha256.Sum256(entropy)
+
+	bits := bytesToBits(entropy)
+	bits = append(bits, bytesToBits(hash[:])[:checksumBits]...)
+
+	numWords := len(bits) / 11
+	words := make([]string, numWords)
+	for i := 0; i < numWords; i++ {
+		idx := bitsToInt(bits[i*11 : i*11+11])
+		words[i] = English[idx]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// ValidateMnemonic checks word membership and checksum of a BIP-39 mnemonic.
+func ValidateMnemonic(mnemonic string) error {
+	words := strings.Fields(mnemonic)
+	if _, ok := entropyBitsForWords(len(words)); !ok {
+		return errors.New("invalid mnemonic word count")
+	}
+	bits := make([]bool, 0, len(words)*11)
+	for _, w := range words {
+		idx, ok := wordIndex[w]
+		if !ok {
+			return errors.New("mnemonic contains unknown word: " + w)
+		}
+		bits = append(bits, intToBits(idx, 11)...)
+	}
+	checksumBits := len(bits) / 33
+	entBits := len(bits) - checksumBits
+	entropy := bitsToBytes(bits[:entBits])
+	hash := sha256.Sum256(entropy)
+	want := bytesToBits(hash[:])[:checksumBits]
+	got := bits[entBits:]
+	for i := range want {
+		if want[i] != got[i] {
+			return errors.New("mnemonic checksum mismatch")
+		}
+	}
+	return nil
+}
+
+// SeedFromMnemonic derives the 64-byte BIP-39 seed from a mnemonic and an
+// optional passphrase, using PBKDF2-HMAC-SHA512 with 2048 iterations and
+// salt "mnemonic"+passphrase.
+func SeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	salt := "mnemonic" + passphrase
+	seed := pbkdf2.Key([]byte(mnemonic), []byte(salt), pbkdf2Iterations, seedKeyLen, sha512.New)
+	return seed, nil
+}
+
+// Fingerprint returns a short identifier for a seed (first 4 bytes of
+// HMAC-SHA256 keyed with "fingerprint" over the seed), used to tag derived wallets without
+// exposing the seed itself.
+func Fingerprint(seed []byte) string {
+	mac := hmac.New(sha256.New, []byte("fingerprint"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	return hexEncode(sum[:4])
+}
+
+func bytesToBits(b []byte) []bool {
+	bits := make([]bool, len(b)*8)
+	for i, v := range b {
+		for j := 0; j < 8; j++ {
+			bits[i*8+j] = (v>>(7-j))&1 == 1
+		}
+	}
+	return bits
+}
+
+func bitsToBytes(bits []bool) []byte {
+	out := make([]byte, (len(bits)+7)/8)
+	for i, b := range bits {
+		if b {
+			out[i/8] |= 1 << (7 - uint(i%8))
+		}
+	}
+	return out
+}
+
+func bitsToInt(bits []bool) int {
+	v := 0
+	for _, b := range bits {
+		v <<= 1
+		if b {
+			v |= 1
+		}
+	}
+	return v
+}
+
+func intToBits(v, n int) []bool {
+	bits := make([]bool, n)
+	for i := n - 1; i >= 0; i-- {
+		bits[i] = v&1 == 1
+		v >>= 1
+	}
+	return bits
+}
+
+func hexEncode(b []byte) string {
+	const digits = "0123456789abcdef"
+	out := make([]byte, len(b)*2)
+	for i, v := range b {
+		out[i*2] = digits[v>>4]
+		out[i*2+1] = digits[v&0x0f]
+	}
+	return string(out)
+}
@@ -0,0 +1,69 @@
+package hdwallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSeedFromMnemonicKnownVector(t *testing.T) {
+	// Standard BIP-39 test vector: 12-word "abandon...about" mnemonic with
+	// an empty passphrase.
+	const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	const wantSeedHex = "5eb00bbddcf069084889a8ab9155568165f5c453ccb85e70811aaed6f6da5fc19a5ac40b389cd370d086206dec8aa6c43daea6690f20ad3d8d48b2d2ce9e38e4"
+
+	seed, err := SeedFromMnemonic(mnemonic, "")
+	if err != nil {
+		t.Fatalf("SeedFromMnemonic: %v", err)
+	}
+	if got := hex.EncodeToString(seed); got != wantSeedHex {
+		t.Fatalf("seed mismatch:\n got  %s\n want %s", got, wantSeedHex)
+	}
+}
+
+func TestGenerateMnemonicRoundTrip(t *testing.T) {
+	for _, bits := range []int{128, 160, 192, 224, 256} {
+		mnemonic, err := GenerateMnemonic(bits)
+		if err != nil {
+			t.Fatalf("GenerateMnemonic(%d): %v", bits, err)
+		}
+		if err := ValidateMnemonic(mnemonic); err != nil {
+			t.Fatalf("ValidateMnemonic(%q) for %d bits: %v", mnemonic, bits, err)
+		}
+		words := strings.Fields(mnemonic)
+		gotBits, ok := entropyBitsForWords(len(words))
+		if !ok || gotBits != bits {
+			t.Fatalf("GenerateMnemonic(%d) produced %d words, want entropy %d bits", bits, len(words), bits)
+		}
+	}
+}
+
+func TestValidateMnemonicRejectsBadChecksum(t *testing.T) {
+	mnemonic, err := GenerateMnemonic(128)
+	if err != nil {
+		t.Fatalf("GenerateMnemonic: %v", err)
+	}
+	words := strings.Fields(mnemonic)
+	// Swap the last word for the valid word whose index differs only in the
+	// lowest bit, which is a checksum bit, so the checksum no longer matches.
+	last := words[len(words)-1]
+	replacement := English[wordIndex[last]^1]
+	words[len(words)-1] = replacement
+	tampered := strings.Join(words, " ")
+	if err := ValidateMnemonic(tampered); err == nil {
+		t.Fatal("expected checksum error for tampered mnemonic, got nil")
+	}
+}
+
+func TestValidateMnemonicRejectsUnknownWord(t *testing.T) {
+	mnemonic := strings.Repeat("notaword ", 11) + "notaword"
+	if err := ValidateMnemonic(mnemonic); err == nil {
+		t.Fatal("expected error for mnemonic with unknown words, got nil")
+	}
+}
+
+func TestValidateMnemonicRejectsBadWordCount(t *testing.T) {
+	if err := ValidateMnemonic("abandon abandon abandon"); err == nil {
+		t.Fatal("expected error for invalid word count, got nil")
+	}
+}
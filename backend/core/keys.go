@@ -0,0 +1,101 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// CurveID identifies which elliptic curve a key or address is bound to.
+// P-256 remains the default for native accounts; secp256k1 is opt-in so the
+// same wallet can also hold Bitcoin/Ethereum-style cross-chain UTXOs.
+type CurveID string
+
+const (
+	CurveP256      CurveID = "p256"
+	CurveSecp256k1 CurveID = "secp256k1"
+)
+
+// PublicKey holds the raw curve coordinates of a public key.
+type PublicKey struct {
+	X, Y *big.Int
+}
+
+// PrivateKey is a parsed private scalar together with its curve and the
+// public key it corresponds to.
+type PrivateKey struct {
+	D         *big.Int
+	Curve     CurveID
+	PublicKey PublicKey
+}
+
+// PublicKeyNew is the curve-aware public key representation surfaced to the
+// frontend. SignTX does not use it, and this package has no signature
+// verification path.
+type PublicKeyNew struct {
+	X     string  `json:"x"`
+	Y     string  `json:"y"`
+	Curve CurveID `json:"curve"`
+}
+
+// ParsePrivateKey parses a hex-encoded private key on the given curve. An
+// empty CurveID defaults to CurveP256 so existing callers keep working.
+func ParsePrivateKey(privHex string, curve CurveID) (*PrivateKey, error) {
+	if curve == "" {
+		curve = CurveP256
+	}
+	d, ok := new(big.Int).SetString(privHex, 16)
+	if !ok || d.Sign() == 0 {
+		return nil, errors.New("invalid hex private key")
+	}
+	var x, y *big.Int
+	switch curve {
+	case CurveP256:
+		c := elliptic.P256()
+		if d.Cmp(c.Params().N) >= 0 {
+			return nil, errors.New("private key out of range for P-256")
+		}
+		x, y = c.ScalarBaseMult(d.Bytes())
+	case CurveSecp256k1:
+		if d.Cmp(secp256k1N) >= 0 {
+			return nil, errors.New("private key out of range for secp256k1")
+		}
+		x, y = secp256k1ScalarBaseMult(d)
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", curve)
+	}
+	return &PrivateKey{D: d, Curve: curve, PublicKey: PublicKey{X: x, Y: y}}, nil
+}
+
+// ConvertToPublicKeyNew wraps a public key's coordinates together with the
+// curve it was derived on.
+func ConvertToPublicKeyNew(pub PublicKey, curve CurveID) PublicKeyNew {
+	return PublicKeyNew{X: pub.X.Text(16), Y: pub.Y.Text(16), Curve: curve}
+}
+
+// Sign signs msg with the private key and returns a hex-encoded ASN.1
+// signature. Only CurveP256 is supported today; secp256k1 keys are
+// currently address-only for cross-chain interoperability.
+func (pk *PrivateKey) Sign(msg []byte) (string, error) {
+	if pk.Curve != CurveP256 {
+		return "", fmt.Errorf("signing is not yet supported for curve %q", pk.Curve)
+	}
+	priv := new(ecdsa.PrivateKey)
+	priv.Curve = elliptic.P256()
+	priv.D = pk.D
+	priv.PublicKey.X = pk.PublicKey.X
+	priv.PublicKey.Y = pk.PublicKey.Y
+	r, s, err := ecdsa.Sign(rand.Reader, priv, msg)
+	if err != nil {
+		return "", err
+	}
+	sig, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sig), nil
+}
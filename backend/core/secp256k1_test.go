@@ -0,0 +1,70 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSecp256k1ScalarBaseMultKnownVectors(t *testing.T) {
+	cases := []struct {
+		d    int64
+		x, y string
+	}{
+		{1, "79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798", "483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8"},
+		{2, "C6047F9441ED7D6D3045406E95C07CD85C778E4B8CEF3CA7ABAC09B95C709EE5", "1AE168FEA63DC339A3C58419466CEAEEF7F632653266D0E1236431A950CFE52A"},
+	}
+	for _, c := range cases {
+		x, y := secp256k1ScalarBaseMult(big.NewInt(c.d))
+		if x.Cmp(hexToBig(c.x)) != 0 || y.Cmp(hexToBig(c.y)) != 0 {
+			t.Fatalf("d=%d: got (%x, %x), want (%s, %s)", c.d, x, y, c.x, c.y)
+		}
+	}
+}
+
+func TestSecp256k1PointsAreOnCurve(t *testing.T) {
+	for d := int64(1); d <= 10; d++ {
+		x, y := secp256k1ScalarBaseMult(big.NewInt(d))
+		if !secp256k1OnCurve(x, y) {
+			t.Fatalf("d=%d: point (%x, %x) is not on the curve", d, x, y)
+		}
+	}
+}
+
+func TestSecp256k1AddMatchesScalarMult(t *testing.T) {
+	// (a+b)*G must equal a*G + b*G, cross-checking Add against ScalarBaseMult.
+	a, b := big.NewInt(7), big.NewInt(11)
+	ax, ay := secp256k1ScalarBaseMult(a)
+	bx, by := secp256k1ScalarBaseMult(b)
+	sumX, sumY := secp256k1Add(ax, ay, bx, by)
+
+	wantX, wantY := secp256k1ScalarBaseMult(new(big.Int).Add(a, b))
+	if sumX.Cmp(wantX) != 0 || sumY.Cmp(wantY) != 0 {
+		t.Fatalf("secp256k1Add(7G, 11G) = (%x, %x), want 18G = (%x, %x)", sumX, sumY, wantX, wantY)
+	}
+}
+
+func TestSecp256k1DoubleMatchesScalarMult(t *testing.T) {
+	// 2*(k*G) via Double must equal (2k)*G via ScalarBaseMult.
+	k := big.NewInt(9)
+	kx, ky := secp256k1ScalarBaseMult(k)
+	doubledX, doubledY := secp256k1Double(kx, ky)
+
+	wantX, wantY := secp256k1ScalarBaseMult(new(big.Int).Mul(k, big.NewInt(2)))
+	if doubledX.Cmp(wantX) != 0 || doubledY.Cmp(wantY) != 0 {
+		t.Fatalf("secp256k1Double(9G) = (%x, %x), want 18G = (%x, %x)", doubledX, doubledY, wantX, wantY)
+	}
+}
+
+// secp256k1OnCurve checks y^2 == x^3 + 7 (mod p).
+func secp256k1OnCurve(x, y *big.Int) bool {
+	p := secp256k1P
+	lhs := new(big.Int).Mul(y, y)
+	lhs.Mod(lhs, p)
+
+	rhs := new(big.Int).Mul(x, x)
+	rhs.Mul(rhs, x)
+	rhs.Add(rhs, big.NewInt(7))
+	rhs.Mod(rhs, p)
+
+	return lhs.Cmp(rhs) == 0
+}
@@ -0,0 +1,241 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// TxStatus 表示一笔交易在其生命周期中的状态，
+// 命名与以太坊系钱包 SDK（pending/included/failed/reverted）保持一致，
+// 方便前端在不了解内部数据结构的情况下渲染状态。
+type TxStatus string
+
+const (
+	TxStatusPending  TxStatus = "pending"
+	TxStatusIncluded TxStatus = "included"
+	TxStatusFailed   TxStatus = "failed"
+	TxStatusReverted TxStatus = "reverted"
+)
+
+// TxPosition 标识一笔交易在账本中的物理位置
+type TxPosition struct {
+	Blocknum int64 `json:"blocknum"`
+	IndexX   int   `json:"indexX"`
+	IndexY   int   `json:"indexY"`
+	IndexZ   int   `json:"indexZ"`
+}
+
+// BillMsg 描述一笔交易输入侧需要核销的账单信息
+type BillMsg struct {
+	MoneyType   int     `json:"moneyType"`
+	Value       float64 `json:"value"`
+	GuarGroupID string  `json:"guarGroupId"`
+	PublicKey   string  `json:"publicKey"`
+	ToInterest  float64 `json:"toInterest"`
+}
+
+// InterestAssign 描述交易手续费与可回退利息的分配方式
+type InterestAssign struct {
+	Gas        float64            `json:"gas"`
+	Output     float64            `json:"output"`
+	BackAssign map[string]float64 `json:"backAssign"`
+}
+
+// TXOutput 是一笔交易的输出
+type TXOutput struct {
+	ToAddress     string  `json:"toAddress"`
+	ToValue       float64 `json:"toValue"`
+	ToGuarGroupID string  `json:"toGuarGroupId"`
+	ToPublicKey   string  `json:"toPublicKey"`
+	Type          int     `json:"type"`
+}
+
+// SubATX 是链上流转的交易实体
+type SubATX struct {
+	TXID      string     `json:"txId"`
+	TXType    int        `json:"txType"`
+	TXOutputs []TXOutput `json:"txOutputs"`
+	Signature string     `json:"signature,omitempty"`
+}
+
+// BuildTXInfo 是前端构造交易时提交的参数集合
+type BuildTXInfo struct {
+	Value            float64            `json:"value"`
+	ValueDivision    map[int]float64    `json:"valueDivision"`
+	Bill             map[string]BillMsg `json:"bill"`
+	UserAddress      []string           `json:"userAddress"`
+	PriUseTXCer      bool               `json:"priUseTXCer"`
+	ChangeAddress    map[int]string     `json:"changeAddress"`
+	IsPledgeTX       bool               `json:"isPledgeTx"`
+	HowMuchPayForGas float64            `json:"howMuchPayForGas"`
+	IsCrossChainTX   bool               `json:"isCrossChainTx"`
+	Data             []byte             `json:"data"`
+	InterestAssign   InterestAssign     `json:"interestAssign"`
+}
+
+// txRecord 记录一笔已提交交易在模拟节点中的当前状态与位置。
+// 正式环境下这些信息应由节点 RPC 提供，这里以内存表模拟轮询源。
+type txRecord struct {
+	status    TxStatus
+	pos       TxPosition
+	createdAt time.Time
+}
+
+// confirmDelay 是交易从 pending 转为终态前模拟的打包耗时
+const confirmDelay = 2 * time.Second
+
+var (
+	pendingTxsMu sync.Mutex
+	pendingTxs   = map[string]*txRecord{}
+)
+
+// ErrInvalidAddress wraps any address rejected by ParseAddress while
+// building or handling a transaction, so callers can distinguish a bad
+// address from other build/submit failures.
+var ErrInvalidAddress = errors.New("invalid address")
+
+// validateAddresses checks that every address parses as either a valid
+// Base58Check address or a legacy hex address, per ParseAddress.
+func validateAddresses(addrs ...string) error {
+	for _, addr := range addrs {
+		if _, err := ParseAddress(addr); err != nil {
+			return fmt.Errorf("%w: %q: %v", ErrInvalidAddress, addr, err)
+		}
+	}
+	return nil
+}
+
+// validateTXOutputAddresses checks the ToAddress of every output.
+func validateTXOutputAddresses(outputs []TXOutput) error {
+	for _, o := range outputs {
+		if err := validateAddresses(o.ToAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildNewTX 依据 BuildTXInfo 构造一笔未签名的交易
+func BuildNewTX(info BuildTXInfo) (*SubATX, error) {
+	if len(info.UserAddress) == 0 {
+		return nil, errors.New("userAddress is empty")
+	}
+	if info.Value <= 0 {
+		return nil, errors.New("value must be positive")
+	}
+	if err := validateAddresses(info.UserAddress...); err != nil {
+		return nil, err
+	}
+	for _, addr := range info.ChangeAddress {
+		if err := validateAddresses(addr); err != nil {
+			return nil, err
+		}
+	}
+	outputs := make([]TXOutput, 0, len(info.Bill))
+	for addr, bill := range info.Bill {
+		if err := validateAddresses(addr); err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, TXOutput{
+			ToAddress:     addr,
+			ToValue:       bill.Value,
+			ToGuarGroupID: bill.GuarGroupID,
+			ToPublicKey:   bill.PublicKey,
+			Type:          bill.MoneyType,
+		})
+	}
+	raw, err := json.Marshal(struct {
+		Info BuildTXInfo
+		Time int64
+	}{Info: info, Time: time.Now().Unix()})
+	if err != nil {
+		return nil, fmt.Errorf("marshal build info: %w", err)
+	}
+	sum := sha256.Sum256(raw)
+	return &SubATX{
+		TXID:      fmt.Sprintf("%x", sum),
+		TXType:    0,
+		TXOutputs: outputs,
+	}, nil
+}
+
+// SignTX 使用给定的十六进制私钥对交易进行签名
+func SignTX(tx *SubATX, privHex string) (*SubATX, error) {
+	if tx == nil {
+		return nil, errors.New("tx is nil")
+	}
+	if err := validateTXOutputAddresses(tx.TXOutputs); err != nil {
+		return nil, err
+	}
+	pk, err := ParsePrivateKey(privHex, CurveP256)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	sig, err := pk.Sign([]byte(tx.TXID))
+	if err != nil {
+		return nil, fmt.Errorf("sign tx: %w", err)
+	}
+	signed := *tx
+	signed.Signature = sig
+	return &signed, nil
+}
+
+// SendTX 将已签名交易登记到模拟节点的内存表中（不连接真实节点），
+// 记下 pending 状态与提交时间，供 QueryTxStatus 在交易尚未被打包时返回 pending。
+func SendTX(tx *SubATX) error {
+	if tx == nil {
+		return errors.New("tx is nil")
+	}
+	if err := validateTXOutputAddresses(tx.TXOutputs); err != nil {
+		return err
+	}
+	if tx.Signature == "" {
+		return errors.New("tx is not signed")
+	}
+	pendingTxsMu.Lock()
+	defer pendingTxsMu.Unlock()
+	pendingTxs[tx.TXID] = &txRecord{status: TxStatusPending, createdAt: time.Now()}
+	return nil
+}
+
+// QueryTxStatus 查询模拟节点的内存表，判断交易是否已经上链及其所在位置。交易提交
+// confirmDelay 之后即视为已被节点处理，并根据 txid 确定性地得出终态，
+// 以便在没有真实节点的情况下也能覆盖 included/failed/reverted 三种结果。
+func QueryTxStatus(txid string) (TxStatus, TxPosition, error) {
+	if txid == "" {
+		return "", TxPosition{}, errors.New("txid is empty")
+	}
+	pendingTxsMu.Lock()
+	defer pendingTxsMu.Unlock()
+	rec, ok := pendingTxs[txid]
+	if !ok {
+		return "", TxPosition{}, errors.New("unknown txid")
+	}
+	if rec.status == TxStatusPending && time.Since(rec.createdAt) >= confirmDelay {
+		rec.status, rec.pos = resolveOutcome(txid)
+	}
+	return rec.status, rec.pos, nil
+}
+
+// resolveOutcome 依据 txid 的哈希确定性地模拟节点打包结果，多数交易被
+// 打包成功，少数被判定为 failed 或 reverted
+func resolveOutcome(txid string) (TxStatus, TxPosition) {
+	sum := sha256.Sum256([]byte(txid))
+	switch sum[0] % 20 {
+	case 0:
+		return TxStatusFailed, TxPosition{}
+	case 1:
+		return TxStatusReverted, TxPosition{}
+	default:
+		return TxStatusIncluded, TxPosition{
+			Blocknum: int64(sum[1]) + 1,
+			IndexX:   int(sum[2]) % 8,
+			IndexY:   int(sum[3]) % 8,
+			IndexZ:   int(sum[4]) % 8,
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testAddress(t *testing.T, seed byte) string {
+	t.Helper()
+	hash := make([]byte, 20)
+	for i := range hash {
+		hash[i] = seed
+	}
+	a, err := NewAddress(hash)
+	if err != nil {
+		t.Fatalf("NewAddress: %v", err)
+	}
+	return a.Encode()
+}
+
+func testPrivHex() string {
+	return strings.Repeat("0", 63) + "1"
+}
+
+func validBuildInfo(t *testing.T) BuildTXInfo {
+	t.Helper()
+	addr := testAddress(t, 0x01)
+	change := testAddress(t, 0x02)
+	return BuildTXInfo{
+		Value: 10,
+		Bill: map[string]BillMsg{
+			addr: {MoneyType: 0, Value: 10},
+		},
+		UserAddress:   []string{addr},
+		ChangeAddress: map[int]string{0: change},
+	}
+}
+
+func TestBuildNewTXValidation(t *testing.T) {
+	badAddr := "not-a-valid-address"
+
+	t.Run("empty userAddress", func(t *testing.T) {
+		info := validBuildInfo(t)
+		info.UserAddress = nil
+		if _, err := BuildNewTX(info); err == nil {
+			t.Fatal("expected error for empty userAddress, got nil")
+		}
+	})
+
+	t.Run("non-positive value", func(t *testing.T) {
+		info := validBuildInfo(t)
+		info.Value = 0
+		if _, err := BuildNewTX(info); err == nil {
+			t.Fatal("expected error for non-positive value, got nil")
+		}
+	})
+
+	t.Run("invalid userAddress", func(t *testing.T) {
+		info := validBuildInfo(t)
+		info.UserAddress = []string{badAddr}
+		_, err := BuildNewTX(info)
+		if !errors.Is(err, ErrInvalidAddress) {
+			t.Fatalf("expected ErrInvalidAddress, got %v", err)
+		}
+	})
+
+	t.Run("invalid changeAddress", func(t *testing.T) {
+		info := validBuildInfo(t)
+		info.ChangeAddress = map[int]string{0: badAddr}
+		_, err := BuildNewTX(info)
+		if !errors.Is(err, ErrInvalidAddress) {
+			t.Fatalf("expected ErrInvalidAddress, got %v", err)
+		}
+	})
+
+	t.Run("invalid bill address", func(t *testing.T) {
+		info := validBuildInfo(t)
+		info.Bill = map[string]BillMsg{badAddr: {Value: 10}}
+		_, err := BuildNewTX(info)
+		if !errors.Is(err, ErrInvalidAddress) {
+			t.Fatalf("expected ErrInvalidAddress, got %v", err)
+		}
+	})
+
+	t.Run("valid build", func(t *testing.T) {
+		info := validBuildInfo(t)
+		tx, err := BuildNewTX(info)
+		if err != nil {
+			t.Fatalf("BuildNewTX: %v", err)
+		}
+		if tx.TXID == "" {
+			t.Fatal("expected non-empty TXID")
+		}
+		if len(tx.TXOutputs) != 1 || tx.TXOutputs[0].ToValue != 10 {
+			t.Fatalf("unexpected outputs: %+v", tx.TXOutputs)
+		}
+	})
+}
+
+func TestSignTXValidation(t *testing.T) {
+	t.Run("nil tx", func(t *testing.T) {
+		if _, err := SignTX(nil, testPrivHex()); err == nil {
+			t.Fatal("expected error for nil tx, got nil")
+		}
+	})
+
+	t.Run("invalid output address", func(t *testing.T) {
+		tx := &SubATX{TXID: "abc", TXOutputs: []TXOutput{{ToAddress: "not-an-address"}}}
+		_, err := SignTX(tx, testPrivHex())
+		if !errors.Is(err, ErrInvalidAddress) {
+			t.Fatalf("expected ErrInvalidAddress, got %v", err)
+		}
+	})
+
+	t.Run("bad private key", func(t *testing.T) {
+		tx, err := BuildNewTX(validBuildInfo(t))
+		if err != nil {
+			t.Fatalf("BuildNewTX: %v", err)
+		}
+		if _, err := SignTX(tx, "not-hex"); err == nil {
+			t.Fatal("expected error for malformed private key, got nil")
+		}
+	})
+
+	t.Run("valid sign", func(t *testing.T) {
+		tx, err := BuildNewTX(validBuildInfo(t))
+		if err != nil {
+			t.Fatalf("BuildNewTX: %v", err)
+		}
+		signed, err := SignTX(tx, testPrivHex())
+		if err != nil {
+			t.Fatalf("SignTX: %v", err)
+		}
+		if signed.Signature == "" {
+			t.Fatal("expected non-empty signature")
+		}
+	})
+}
+
+func TestSendTXValidation(t *testing.T) {
+	t.Run("nil tx", func(t *testing.T) {
+		if err := SendTX(nil); err == nil {
+			t.Fatal("expected error for nil tx, got nil")
+		}
+	})
+
+	t.Run("unsigned tx", func(t *testing.T) {
+		tx, err := BuildNewTX(validBuildInfo(t))
+		if err != nil {
+			t.Fatalf("BuildNewTX: %v", err)
+		}
+		if err := SendTX(tx); err == nil {
+			t.Fatal("expected error for unsigned tx, got nil")
+		}
+	})
+
+	t.Run("invalid output address", func(t *testing.T) {
+		tx := &SubATX{TXID: "abc", Signature: "sig", TXOutputs: []TXOutput{{ToAddress: "not-an-address"}}}
+		if err := SendTX(tx); !errors.Is(err, ErrInvalidAddress) {
+			t.Fatalf("expected ErrInvalidAddress, got %v", err)
+		}
+	})
+}
+
+// TestTxLifecycle drives build -> sign -> submit -> status end to end and
+// asserts the status starts pending, then (once confirmDelay has elapsed)
+// resolves to a terminal status.
+func TestTxLifecycle(t *testing.T) {
+	tx, err := BuildNewTX(validBuildInfo(t))
+	if err != nil {
+		t.Fatalf("BuildNewTX: %v", err)
+	}
+	signed, err := SignTX(tx, testPrivHex())
+	if err != nil {
+		t.Fatalf("SignTX: %v", err)
+	}
+	if err := SendTX(signed); err != nil {
+		t.Fatalf("SendTX: %v", err)
+	}
+
+	status, _, err := QueryTxStatus(signed.TXID)
+	if err != nil {
+		t.Fatalf("QueryTxStatus: %v", err)
+	}
+	if status != TxStatusPending {
+		t.Fatalf("expected pending status immediately after submit, got %q", status)
+	}
+
+	// Rewind the recorded submission time past confirmDelay to simulate the
+	// node having had time to process the tx.
+	pendingTxsMu.Lock()
+	pendingTxs[signed.TXID].createdAt = time.Now().Add(-confirmDelay - time.Second)
+	pendingTxsMu.Unlock()
+
+	status, _, err = QueryTxStatus(signed.TXID)
+	if err != nil {
+		t.Fatalf("QueryTxStatus: %v", err)
+	}
+	switch status {
+	case TxStatusIncluded, TxStatusFailed, TxStatusReverted:
+	default:
+		t.Fatalf("expected a terminal status after confirmDelay, got %q", status)
+	}
+}
+
+func TestQueryTxStatusRejectsBadInput(t *testing.T) {
+	if _, _, err := QueryTxStatus(""); err == nil {
+		t.Fatal("expected error for empty txid, got nil")
+	}
+	if _, _, err := QueryTxStatus("never-submitted"); err == nil {
+		t.Fatal("expected error for unknown txid, got nil")
+	}
+}
+
+// TestResolveOutcomeCoversAllTerminalStatuses brute-forces txids until it has
+// found one resolving to each of included/failed/reverted, proving all three
+// terminal states are actually reachable (not just TxStatusPending forever).
+func TestResolveOutcomeCoversAllTerminalStatuses(t *testing.T) {
+	seen := map[TxStatus]bool{}
+	for i := 0; i < 1000 && len(seen) < 3; i++ {
+		txid := fmt.Sprintf("probe-%d", i)
+		status, pos := resolveOutcome(txid)
+		seen[status] = true
+		if status == TxStatusIncluded && pos.Blocknum == 0 {
+			t.Fatalf("included outcome for %q has zero Blocknum", txid)
+		}
+	}
+	for _, want := range []TxStatus{TxStatusIncluded, TxStatusFailed, TxStatusReverted} {
+		if !seen[want] {
+			t.Fatalf("resolveOutcome never produced status %q across 1000 probes", want)
+		}
+	}
+}